@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2022 Per Alexander Fougner
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package maps
+
+import (
+	"testing"
+
+	"gopkg.in/typ.v4/sets"
+)
+
+// TestSet_interopWithSyncSet proves that a map-backed Set and a SyncSet can
+// be mixed through the abstract sets.Set interface, not just that the two
+// types happen to compile against it.
+func TestSet_interopWithSyncSet(t *testing.T) {
+	plain := NewSetFromSlice([]int{1, 2, 3})
+	sync := sets.NewSyncSetFromSlice([]int{2, 3, 4})
+
+	t.Run("AddSet from a SyncSet into a plain Set", func(t *testing.T) {
+		dst := NewSetFromSlice([]int{1})
+		added := dst.AddSet(sync)
+		if added != 3 {
+			t.Fatalf("AddSet() = %d, want 3", added)
+		}
+		for _, v := range []int{1, 2, 3, 4} {
+			if !dst.Has(v) {
+				t.Errorf("Has(%d) = false, want true", v)
+			}
+		}
+	})
+
+	t.Run("RemoveSet from a plain Set using a SyncSet", func(t *testing.T) {
+		dst := NewSetFromSlice([]int{1, 2, 3})
+		removed := dst.RemoveSet(sync)
+		if removed != 2 {
+			t.Fatalf("RemoveSet() = %d, want 2", removed)
+		}
+		if !dst.Has(1) || dst.Has(2) || dst.Has(3) {
+			t.Errorf("unexpected contents after RemoveSet(): %v", dst)
+		}
+	})
+
+	t.Run("Intersect a plain Set with a SyncSet", func(t *testing.T) {
+		got := plain.Intersect(sync)
+		want := []int{2, 3}
+		for _, v := range want {
+			if !got.Has(v) {
+				t.Errorf("Intersect() missing %d", v)
+			}
+		}
+		if got.Len() != len(want) {
+			t.Errorf("Intersect() = %v, want %d elements", got, len(want))
+		}
+	})
+
+	t.Run("Union a plain Set with a SyncSet", func(t *testing.T) {
+		got := plain.Union(sync)
+		for _, v := range []int{1, 2, 3, 4} {
+			if !got.Has(v) {
+				t.Errorf("Union() missing %d", v)
+			}
+		}
+		if got.Len() != 4 {
+			t.Errorf("Union() = %v, want 4 elements", got)
+		}
+	})
+
+	t.Run("SetDiff a plain Set against a SyncSet", func(t *testing.T) {
+		got := plain.SetDiff(sync)
+		if got.Len() != 1 || !got.Has(1) {
+			t.Errorf("SetDiff() = %v, want {1}", got)
+		}
+	})
+
+	t.Run("IsSubset/IsDisjoint across implementations", func(t *testing.T) {
+		small := NewSetFromSlice([]int{2, 3})
+		if !small.IsSubset(sync) {
+			t.Error("small.IsSubset(sync) = false, want true")
+		}
+		disjoint := NewSetFromSlice([]int{100})
+		if !disjoint.IsDisjoint(sync) {
+			t.Error("disjoint.IsDisjoint(sync) = false, want true")
+		}
+	})
+}
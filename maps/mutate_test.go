@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022 Per Alexander Fougner
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package maps
+
+import "testing"
+
+func TestSet_Add_variadic(t *testing.T) {
+	s := NewSetFromSlice([]int{1, 2})
+
+	if added := s.Add(2, 3, 4); added != 2 {
+		t.Fatalf("Add(2, 3, 4) = %d, want 2", added)
+	}
+	if s.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", s.Len())
+	}
+	for _, v := range []int{1, 2, 3, 4} {
+		if !s.Has(v) {
+			t.Errorf("Has(%d) = false, want true", v)
+		}
+	}
+}
+
+func TestSet_Remove_variadic(t *testing.T) {
+	s := NewSetFromSlice([]int{1, 2, 3})
+
+	if removed := s.Remove(2, 3, 4); removed != 2 {
+		t.Fatalf("Remove(2, 3, 4) = %d, want 2", removed)
+	}
+	if s.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", s.Len())
+	}
+	if !s.Has(1) {
+		t.Error("Has(1) = false, want true")
+	}
+}
+
+func TestSet_Pop(t *testing.T) {
+	s := NewSetFromSlice([]int{1, 2, 3})
+
+	seen := make(Set[int])
+	for i := 0; i < 3; i++ {
+		v, ok := s.Pop()
+		if !ok {
+			t.Fatalf("Pop() ok = false on iteration %d, want true", i)
+		}
+		if seen.Has(v) {
+			t.Fatalf("Pop() returned %d twice", v)
+		}
+		seen.Add(v)
+	}
+	if !s.IsEmpty() {
+		t.Fatalf("set should be empty after popping all elements, got %v", s)
+	}
+
+	_, ok := s.Pop()
+	if ok {
+		t.Error("Pop() on empty set ok = true, want false")
+	}
+}
+
+func TestSet_Clear(t *testing.T) {
+	s := NewSetFromSlice([]int{1, 2, 3})
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatalf("Clear() left set = %v, want empty", s)
+	}
+	if s.Add(4) != 1 {
+		t.Error("set should still be usable after Clear()")
+	}
+}
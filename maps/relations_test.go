@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022 Per Alexander Fougner
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package maps
+
+import "testing"
+
+func TestSet_IsEmpty(t *testing.T) {
+	if !make(Set[int]).IsEmpty() {
+		t.Error("IsEmpty() on empty set = false, want true")
+	}
+	if NewSetFromSlice([]int{1}).IsEmpty() {
+		t.Error("IsEmpty() on non-empty set = true, want false")
+	}
+}
+
+func TestSet_Equal(t *testing.T) {
+	a := NewSetFromSlice([]int{1, 2, 3})
+	b := NewSetFromSlice([]int{3, 2, 1})
+	c := NewSetFromSlice([]int{1, 2})
+
+	if !a.Equal(b) {
+		t.Error("Equal() on identical sets = false, want true")
+	}
+	if a.Equal(c) {
+		t.Error("Equal() on a superset vs a subset = true, want false")
+	}
+}
+
+func TestSet_IsSubset(t *testing.T) {
+	small := NewSetFromSlice([]int{1, 2})
+	big := NewSetFromSlice([]int{1, 2, 3})
+
+	if !small.IsSubset(big) {
+		t.Error("IsSubset() = false, want true")
+	}
+	if big.IsSubset(small) {
+		t.Error("IsSubset() on a larger set = true, want false (size short-circuit)")
+	}
+	if !big.IsSubset(big) {
+		t.Error("IsSubset() of a set against itself = false, want true")
+	}
+}
+
+func TestSet_IsSuperset(t *testing.T) {
+	small := NewSetFromSlice([]int{1, 2})
+	big := NewSetFromSlice([]int{1, 2, 3})
+
+	if !big.IsSuperset(small) {
+		t.Error("IsSuperset() = false, want true")
+	}
+	if small.IsSuperset(big) {
+		t.Error("IsSuperset() on a smaller set = true, want false (size short-circuit)")
+	}
+}
+
+func TestSet_IsProperSubset(t *testing.T) {
+	small := NewSetFromSlice([]int{1, 2})
+	big := NewSetFromSlice([]int{1, 2, 3})
+	equalToBig := NewSetFromSlice([]int{1, 2, 3})
+
+	if !small.IsProperSubset(big) {
+		t.Error("IsProperSubset() = false, want true")
+	}
+	if big.IsProperSubset(equalToBig) {
+		t.Error("IsProperSubset() on equal-size sets = true, want false")
+	}
+}
+
+func TestSet_IsProperSuperset(t *testing.T) {
+	small := NewSetFromSlice([]int{1, 2})
+	big := NewSetFromSlice([]int{1, 2, 3})
+	equalToSmall := NewSetFromSlice([]int{1, 2})
+
+	if !big.IsProperSuperset(small) {
+		t.Error("IsProperSuperset() = false, want true")
+	}
+	if small.IsProperSuperset(equalToSmall) {
+		t.Error("IsProperSuperset() on equal-size sets = true, want false")
+	}
+}
+
+func TestSet_IsDisjoint(t *testing.T) {
+	a := NewSetFromSlice([]int{1, 2})
+	b := NewSetFromSlice([]int{3, 4})
+	c := NewSetFromSlice([]int{2, 3})
+
+	if !a.IsDisjoint(b) {
+		t.Error("IsDisjoint() on non-overlapping sets = false, want true")
+	}
+	if a.IsDisjoint(c) {
+		t.Error("IsDisjoint() on overlapping sets = true, want false")
+	}
+}
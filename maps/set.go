@@ -6,6 +6,9 @@
 package maps
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -72,14 +75,18 @@ func (s Set[T]) Has(value T) bool {
 	return has
 }
 
-// Add will add an element to the set, and return true if it was added
-// or false if the value already existed in the set.
-func (s Set[T]) Add(value T) bool {
-	if s.Has(value) {
-		return false
+// Add will add the given values to the set, and return the number of
+// values that were added, i.e. that did not already exist in the set.
+func (s Set[T]) Add(values ...T) int {
+	var added int
+	for _, value := range values {
+		if s.Has(value) {
+			continue
+		}
+		s[value] = struct{}{}
+		added++
 	}
-	s[value] = struct{}{}
-	return true
+	return added
 }
 
 // AddSet will add all element found in specified set to this set, and
@@ -87,22 +94,24 @@ func (s Set[T]) Add(value T) bool {
 func (s Set[T]) AddSet(set sets.Set[T]) int {
 	var added int
 	set.Range(func(value T) bool {
-		if s.Add(value) {
-			added++
-		}
+		added += s.Add(value)
 		return true
 	})
 	return added
 }
 
-// Remove will remove an element from the set, and return true if it was removed
-// or false if no such value existed in the set.
-func (s Set[T]) Remove(value T) bool {
-	if !s.Has(value) {
-		return false
+// Remove will remove the given values from the set, and return the number
+// of values that were removed, i.e. that existed in the set.
+func (s Set[T]) Remove(values ...T) int {
+	var removed int
+	for _, value := range values {
+		if !s.Has(value) {
+			continue
+		}
+		delete(s, value)
+		removed++
 	}
-	delete(s, value)
-	return true
+	return removed
 }
 
 // RemoveSet will remove all element found in specified set from this set, and
@@ -110,14 +119,32 @@ func (s Set[T]) Remove(value T) bool {
 func (s Set[T]) RemoveSet(set sets.Set[T]) int {
 	var removed int
 	set.Range(func(value T) bool {
-		if s.Remove(value) {
-			removed++
-		}
+		removed += s.Remove(value)
 		return true
 	})
 	return removed
 }
 
+// Pop removes and returns an arbitrary element from the set. The second
+// return value is false if the set was empty, in which case the first
+// return value is the zero value of T.
+func (s Set[T]) Pop() (T, bool) {
+	for v := range s {
+		delete(s, v)
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Clear removes all elements from the set in-place, retaining its
+// underlying capacity.
+func (s Set[T]) Clear() {
+	for v := range s {
+		delete(s, v)
+	}
+}
+
 // Clone returns a copy of the set.
 func (s Set[T]) Clone() sets.Set[T] {
 	clone := make(Set[T])
@@ -136,6 +163,47 @@ func (s Set[T]) Slice() []T {
 	return result
 }
 
+// MarshalJSON converts this set to its JSON representation, a JSON array of
+// its values. An empty set is marshaled as "[]", never "null".
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON populates this set from its JSON representation, a JSON
+// array of values. It returns an error if data is neither a JSON array nor
+// the JSON null literal; null results in an empty set.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("maps: Set: unmarshal JSON: expected a JSON array: %w", err)
+	}
+	*s = make(Set[T], len(values))
+	s.Add(values...)
+	return nil
+}
+
+// GobEncode converts this set to its gob representation, encoding its
+// values as a slice.
+func (s Set[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.Slice()); err != nil {
+		return nil, fmt.Errorf("maps: Set: gob encode: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode populates this set from its gob representation, as encoded by
+// GobEncode.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return fmt.Errorf("maps: Set: gob decode: %w", err)
+	}
+	*s = make(Set[T], len(values))
+	s.Add(values...)
+	return nil
+}
+
 // Intersect performs an "intersection" on the sets and returns a new set.
 // An intersection is a set of all elements that appear in both sets. In
 // mathematics it's denoted as:
@@ -223,3 +291,67 @@ func (s Set[T]) Range(f func(value T) bool) {
 		}
 	}
 }
+
+// IsEmpty returns true if the set contains no elements.
+func (s Set[T]) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Equal returns true if this set and other contain exactly the same
+// elements. In mathematics it's denoted as:
+// 	A = B
+func (s Set[T]) Equal(other sets.Set[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	return s.IsSubset(other)
+}
+
+// IsSubset returns true if other contains every element of this set. In
+// mathematics it's denoted as:
+// 	A ⊆ B
+func (s Set[T]) IsSubset(other sets.Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if this set contains every element of other. In
+// mathematics it's denoted as:
+// 	A ⊇ B
+func (s Set[T]) IsSuperset(other sets.Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsProperSubset returns true if other contains every element of this set,
+// and other has more elements than this set. In mathematics it's denoted as:
+// 	A ⊂ B
+func (s Set[T]) IsProperSubset(other sets.Set[T]) bool {
+	return s.Len() < other.Len() && s.IsSubset(other)
+}
+
+// IsProperSuperset returns true if this set contains every element of
+// other, and this set has more elements than other. In mathematics it's
+// denoted as:
+// 	A ⊃ B
+func (s Set[T]) IsProperSuperset(other sets.Set[T]) bool {
+	return other.IsProperSubset(s)
+}
+
+// IsDisjoint returns true if this set and other share no elements. In
+// mathematics it's denoted as:
+// 	A ∩ B = ∅
+func (s Set[T]) IsDisjoint(other sets.Set[T]) bool {
+	for v := range s {
+		if other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
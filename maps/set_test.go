@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2022 Per Alexander Fougner
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package maps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSet_MarshalJSON_empty(t *testing.T) {
+	s := make(Set[int])
+	data, err := s.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if string(data) != "[]" {
+		t.Fatalf("MarshalJSON() = %s, want []", data)
+	}
+}
+
+func TestSet_JSON_roundTrip(t *testing.T) {
+	want := NewSetFromSlice([]int{1, 2, 3})
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got Set[int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !want.Equal(got) {
+		t.Errorf("round-tripped set = %v, want %v", got, want)
+	}
+}
+
+func TestSet_UnmarshalJSON_notAnArray(t *testing.T) {
+	var s Set[int]
+	err := json.Unmarshal([]byte(`{"a":1}`), &s)
+	if err == nil {
+		t.Fatal("UnmarshalJSON() error = nil, want an error for non-array input")
+	}
+	if !strings.Contains(err.Error(), "expected a JSON array") {
+		t.Errorf("UnmarshalJSON() error = %q, want it to mention the expected JSON array", err)
+	}
+}
+
+func TestSet_Gob_roundTrip(t *testing.T) {
+	want := NewSetFromSlice([]string{"a", "b", "c"})
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("gob encode error = %v", err)
+	}
+
+	var got Set[string]
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decode error = %v", err)
+	}
+	if !want.Equal(got) {
+		t.Errorf("round-tripped set = %v, want %v", got, want)
+	}
+}
@@ -5,6 +5,10 @@
 package typ
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -75,6 +79,51 @@ func (s Set[T]) Slice() []T {
 	return result
 }
 
+// MarshalJSON converts this set to its JSON representation, a JSON array of
+// its values. An empty set is marshaled as "[]", never "null".
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON populates this set from its JSON representation, a JSON
+// array of values. It returns an error if data is neither a JSON array nor
+// the JSON null literal; null results in an empty set.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("typ: Set: unmarshal JSON: expected a JSON array: %w", err)
+	}
+	*s = make(Set[T], len(values))
+	for _, v := range values {
+		s.Set(v)
+	}
+	return nil
+}
+
+// GobEncode converts this set to its gob representation, encoding its
+// values as a slice.
+func (s Set[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.Slice()); err != nil {
+		return nil, fmt.Errorf("typ: Set: gob encode: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode populates this set from its gob representation, as encoded by
+// GobEncode.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return fmt.Errorf("typ: Set: gob decode: %w", err)
+	}
+	*s = make(Set[T], len(values))
+	for _, v := range values {
+		s.Set(v)
+	}
+	return nil
+}
+
 // Intersect performs an "intersection" on the sets and returns a new set.
 // An intersection is a set of all elements that appear in both sets. In
 // mathmatics it's denoted as:
@@ -186,4 +235,94 @@ func CartesianProduct[TA comparable, TB comparable](a Set[TA], b Set[TB]) Set[Se
 type SetProduct[TA comparable, TB comparable] struct {
 	A TA
 	B TB
+}
+
+// CartesianProduct3 performs a "Cartesian product" on three sets and returns
+// a new set of all possible combinations between them. It is the 3-operand
+// counterpart to CartesianProduct. In mathmatics it's denoted as:
+// 	A × B × C
+func CartesianProduct3[TA comparable, TB comparable, TC comparable](a Set[TA], b Set[TB], c Set[TC]) Set[SetProduct3[TA, TB, TC]] {
+	result := make(Set[SetProduct3[TA, TB, TC]])
+	for valueA := range a {
+		for valueB := range b {
+			for valueC := range c {
+				result.Set(SetProduct3[TA, TB, TC]{valueA, valueB, valueC})
+			}
+		}
+	}
+	return result
+}
+
+// SetProduct3 is the resulting type from a 3-operand Cartesian product
+// operation.
+type SetProduct3[TA comparable, TB comparable, TC comparable] struct {
+	A TA
+	B TB
+	C TC
+}
+
+// CartesianProductN performs a "Cartesian product" on any number of
+// same-typed sets and returns all combinations of picking one element from
+// each set, in the order the sets were given. In mathmatics it's denoted as:
+// 	S₁ × S₂ × ... × Sₙ
+// Each combination is returned as a slice rather than as an element of a
+// Set, since a slice cannot satisfy the comparable constraint required to be
+// a set element or map key. Calling CartesianProductN with no sets returns
+// nil.
+func CartesianProductN[T comparable](sets ...Set[T]) [][]T {
+	if len(sets) == 0 {
+		return nil
+	}
+	combos := [][]T{{}}
+	for _, s := range sets {
+		next := make([][]T, 0, len(combos)*len(s))
+		for _, combo := range combos {
+			for value := range s {
+				tuple := make([]T, len(combo), len(combo)+1)
+				copy(tuple, combo)
+				next = append(next, append(tuple, value))
+			}
+		}
+		combos = next
+	}
+	return combos
+}
+
+// maxPowerSetLen is the largest set size that PowerSet will operate on. A
+// set of this size already yields 2^20 (over a million) subsets, so larger
+// inputs are rejected to avoid silently exhausting memory.
+const maxPowerSetLen = 20
+
+// ErrPowerSetTooLarge is returned by PowerSet when its input set has more
+// elements than maxPowerSetLen, since the result grows as 2^|s|.
+var ErrPowerSetTooLarge = errors.New("typ: PowerSet: set is too large")
+
+// PowerSet computes the "power set" of s, the set of all possible subsets of
+// s, including the empty set and s itself. In mathmatics it's denoted as:
+// 	2^S
+// Example:
+// 	2^{1 2} = {{} {1} {2} {1 2}}
+// The subsets are returned as a slice rather than as elements of a Set,
+// since Set is implemented as a Go map and maps cannot satisfy the
+// comparable constraint required to be a set element or map key.
+//
+// PowerSet returns ErrPowerSetTooLarge if s has more than maxPowerSetLen
+// elements, rather than materializing a result of that size.
+func PowerSet[T comparable](s Set[T]) ([]Set[T], error) {
+	elems := s.Slice()
+	if len(elems) > maxPowerSetLen {
+		return nil, fmt.Errorf("%w: has %d elements, want at most %d (2^%d subsets)", ErrPowerSetTooLarge, len(elems), maxPowerSetLen, maxPowerSetLen)
+	}
+	subsetCount := 1 << len(elems)
+	result := make([]Set[T], 0, subsetCount)
+	for mask := 0; mask < subsetCount; mask++ {
+		subset := make(Set[T])
+		for i, value := range elems {
+			if mask&(1<<i) != 0 {
+				subset.Set(value)
+			}
+		}
+		result = append(result, subset)
+	}
+	return result, nil
 }
\ No newline at end of file
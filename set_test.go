@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package typ
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func setOf[T comparable](values ...T) Set[T] {
+	s := make(Set[T])
+	for _, v := range values {
+		s.Set(v)
+	}
+	return s
+}
+
+func tupleStrings(tuples [][]int) []string {
+	result := make([]string, len(tuples))
+	for i, tuple := range tuples {
+		result[i] = intsToString(tuple)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func intsToString(values []int) string {
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.Itoa(v))
+	}
+	return sb.String()
+}
+
+func TestCartesianProduct3(t *testing.T) {
+	t.Run("combines all operands", func(t *testing.T) {
+		got := CartesianProduct3(setOf(1, 2), setOf("a"), setOf(true))
+		want := map[SetProduct3[int, string, bool]]bool{
+			{1, "a", true}: true,
+			{2, "a", true}: true,
+		}
+		if len(got) != len(want) {
+			t.Fatalf("len(CartesianProduct3()) = %d, want %d", len(got), len(want))
+		}
+		for product := range got {
+			if !want[product] {
+				t.Errorf("unexpected product %+v", product)
+			}
+		}
+	})
+
+	t.Run("empty operand collapses the product to empty", func(t *testing.T) {
+		got := CartesianProduct3(setOf(1, 2), setOf[string](), setOf(true))
+		if len(got) != 0 {
+			t.Fatalf("CartesianProduct3 with an empty operand = %v, want empty", got)
+		}
+	})
+}
+
+func TestCartesianProductN(t *testing.T) {
+	t.Run("no sets returns nil", func(t *testing.T) {
+		got := CartesianProductN[int]()
+		if got != nil {
+			t.Fatalf("CartesianProductN() = %v, want nil", got)
+		}
+	})
+
+	t.Run("a zero-length operand collapses the product to empty", func(t *testing.T) {
+		got := CartesianProductN(setOf(1, 2), setOf[int](), setOf(3))
+		if len(got) != 0 {
+			t.Fatalf("CartesianProductN with an empty operand = %v, want empty", got)
+		}
+	})
+
+	t.Run("combines all operands", func(t *testing.T) {
+		got := CartesianProductN(setOf(1, 2), setOf(3, 4))
+		want := []string{"1,3", "1,4", "2,3", "2,4"}
+		gotStrings := tupleStrings(got)
+		if len(gotStrings) != len(want) {
+			t.Fatalf("CartesianProductN() = %v, want %v", gotStrings, want)
+		}
+		for i := range want {
+			if gotStrings[i] != want[i] {
+				t.Errorf("CartesianProductN()[%d] = %q, want %q", i, gotStrings[i], want[i])
+			}
+		}
+	})
+
+	t.Run("single set returns single-element tuples", func(t *testing.T) {
+		got := CartesianProductN(setOf(1, 2, 3))
+		if len(got) != 3 {
+			t.Fatalf("len(CartesianProductN(single set)) = %d, want 3", len(got))
+		}
+		for _, tuple := range got {
+			if len(tuple) != 1 {
+				t.Errorf("tuple %v has length %d, want 1", tuple, len(tuple))
+			}
+		}
+	})
+}
+
+func TestPowerSet(t *testing.T) {
+	t.Run("empty set", func(t *testing.T) {
+		got, err := PowerSet(setOf[int]())
+		if err != nil {
+			t.Fatalf("PowerSet(empty) error = %v", err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("len(PowerSet(empty)) = %d, want 1", len(got))
+		}
+		if len(got[0]) != 0 {
+			t.Errorf("PowerSet(empty)[0] = %v, want empty set", got[0])
+		}
+	})
+
+	t.Run("two elements", func(t *testing.T) {
+		got, err := PowerSet(setOf(1, 2))
+		if err != nil {
+			t.Fatalf("PowerSet({1 2}) error = %v", err)
+		}
+		if len(got) != 4 {
+			t.Fatalf("len(PowerSet({1 2})) = %d, want 4", len(got))
+		}
+		var sizes []int
+		for _, subset := range got {
+			sizes = append(sizes, len(subset))
+		}
+		sort.Ints(sizes)
+		wantSizes := []int{0, 1, 1, 2}
+		for i := range wantSizes {
+			if sizes[i] != wantSizes[i] {
+				t.Errorf("subset sizes = %v, want %v", sizes, wantSizes)
+			}
+		}
+	})
+
+	t.Run("errors beyond maxPowerSetLen", func(t *testing.T) {
+		values := make([]int, maxPowerSetLen+1)
+		for i := range values {
+			values[i] = i
+		}
+		s := setOf(values...)
+
+		got, err := PowerSet(s)
+		if err == nil {
+			t.Fatal("PowerSet did not return an error for a set larger than maxPowerSetLen")
+		}
+		if !errors.Is(err, ErrPowerSetTooLarge) {
+			t.Errorf("PowerSet error = %v, want it to wrap ErrPowerSetTooLarge", err)
+		}
+		if got != nil {
+			t.Errorf("PowerSet result = %v, want nil", got)
+		}
+	})
+}
+
+func TestSet_MarshalJSON_empty(t *testing.T) {
+	data, err := setOf[int]().MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if string(data) != "[]" {
+		t.Fatalf("MarshalJSON() = %s, want []", data)
+	}
+}
+
+func TestSet_JSON_roundTrip(t *testing.T) {
+	want := setOf(1, 2, 3)
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got Set[int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round-tripped set = %v, want %v", got, want)
+	}
+}
+
+func TestSet_UnmarshalJSON_notAnArray(t *testing.T) {
+	var s Set[int]
+	err := json.Unmarshal([]byte(`{"a":1}`), &s)
+	if err == nil {
+		t.Fatal("UnmarshalJSON() error = nil, want an error for non-array input")
+	}
+	if !strings.Contains(err.Error(), "expected a JSON array") {
+		t.Errorf("UnmarshalJSON() error = %q, want it to mention the expected JSON array", err)
+	}
+}
+
+func TestSet_Gob_roundTrip(t *testing.T) {
+	want := setOf("a", "b", "c")
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("gob encode error = %v", err)
+	}
+
+	var got Set[string]
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decode error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round-tripped set = %v, want %v", got, want)
+	}
+}
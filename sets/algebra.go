@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sets
+
+// Union performs a "union" on any number of sets and returns a new set of
+// all elements that appear in at least one of them. In mathematics it's
+// denoted as:
+// 	S₁ ∪ S₂ ∪ ... ∪ Sₙ
+// Calling Union with no sets returns an empty set.
+//
+// The returned Set is backed by a *SyncSet, since package sets has no
+// non-locking concrete set implementation of its own to avoid an import
+// cycle with package maps. Callers pay its mutex overhead even though this
+// function itself does no concurrent work.
+func Union[T comparable](sets ...Set[T]) Set[T] {
+	result := NewSyncSet[T]()
+	for _, s := range sets {
+		result.AddSet(s)
+	}
+	return result
+}
+
+// Intersect performs an "intersection" on any number of sets and returns a
+// new set of all elements that appear in every one of them. In mathematics
+// it's denoted as:
+// 	S₁ ∩ S₂ ∩ ... ∩ Sₙ
+// Calling Intersect with no sets returns an empty set.
+//
+// To keep the work proportional to the smallest input rather than the sum
+// of all inputs, it ranges over the smallest set and checks membership in
+// the rest, exiting early on the first miss.
+//
+// The returned Set is backed by a *SyncSet; see the Union doc comment for
+// why.
+func Intersect[T comparable](sets ...Set[T]) Set[T] {
+	result := NewSyncSet[T]()
+	if len(sets) == 0 {
+		return result
+	}
+	smallestIndex := 0
+	for i, s := range sets {
+		if s.Len() < sets[smallestIndex].Len() {
+			smallestIndex = i
+		}
+	}
+	sets[smallestIndex].Range(func(value T) bool {
+		for i, s := range sets {
+			if i == smallestIndex {
+				continue
+			}
+			if !s.Has(value) {
+				return true
+			}
+		}
+		result.Add(value)
+		return true
+	})
+	return result
+}
+
+// SetDiff performs a "set difference" of base against any number of other
+// sets, and returns a new set of all elements in base that do not appear in
+// any of the others. In mathematics it's denoted as:
+// 	B \ (S₁ ∪ S₂ ∪ ... ∪ Sₙ)
+// Calling SetDiff with no other sets returns a copy of base.
+//
+// The returned Set is backed by a *SyncSet; see the Union doc comment for
+// why.
+func SetDiff[T comparable](base Set[T], others ...Set[T]) Set[T] {
+	result := NewSyncSet[T]()
+	base.Range(func(value T) bool {
+		for _, o := range others {
+			if o.Has(value) {
+				return true
+			}
+		}
+		result.Add(value)
+		return true
+	})
+	return result
+}
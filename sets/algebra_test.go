@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sets
+
+import (
+	"sort"
+	"testing"
+)
+
+func sortedSlice[T int | string](s Set[T]) []T {
+	var result []T
+	s.Range(func(value T) bool {
+		result = append(result, value)
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("zero operands", func(t *testing.T) {
+		got := sortedSlice(Union[int]())
+		if len(got) != 0 {
+			t.Fatalf("Union() = %v, want empty", got)
+		}
+	})
+
+	t.Run("one operand", func(t *testing.T) {
+		got := sortedSlice(Union[int](NewSyncSetFromSlice([]int{1, 2})))
+		want := []int{1, 2}
+		if !equalSlices(got, want) {
+			t.Fatalf("Union(a) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("three operands", func(t *testing.T) {
+		a := NewSyncSetFromSlice([]int{1, 2})
+		b := NewSyncSetFromSlice([]int{2, 3})
+		c := NewSyncSetFromSlice([]int{3, 4})
+		got := sortedSlice(Union[int](a, b, c))
+		want := []int{1, 2, 3, 4}
+		if !equalSlices(got, want) {
+			t.Fatalf("Union(a, b, c) = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestIntersect(t *testing.T) {
+	t.Run("zero operands", func(t *testing.T) {
+		got := sortedSlice(Intersect[int]())
+		if len(got) != 0 {
+			t.Fatalf("Intersect() = %v, want empty", got)
+		}
+	})
+
+	t.Run("one operand", func(t *testing.T) {
+		got := sortedSlice(Intersect[int](NewSyncSetFromSlice([]int{1, 2})))
+		want := []int{1, 2}
+		if !equalSlices(got, want) {
+			t.Fatalf("Intersect(a) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("three operands", func(t *testing.T) {
+		a := NewSyncSetFromSlice([]int{1, 2, 3, 4})
+		b := NewSyncSetFromSlice([]int{2, 3, 4, 5})
+		c := NewSyncSetFromSlice([]int{2, 3, 6})
+		got := sortedSlice(Intersect[int](a, b, c))
+		want := []int{2, 3}
+		if !equalSlices(got, want) {
+			t.Fatalf("Intersect(a, b, c) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("smallest set is not the first argument", func(t *testing.T) {
+		// The smallest set (c) is last; the early-exit optimization must
+		// still range over it (rather than always the first argument) and
+		// produce the correct answer.
+		a := NewSyncSetFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8})
+		b := NewSyncSetFromSlice([]int{2, 3, 4, 5, 6, 7, 8, 9})
+		c := NewSyncSetFromSlice([]int{3, 7})
+		got := sortedSlice(Intersect[int](a, b, c))
+		want := []int{3, 7}
+		if !equalSlices(got, want) {
+			t.Fatalf("Intersect(a, b, c) = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestSetDiff(t *testing.T) {
+	t.Run("zero others returns a copy of base", func(t *testing.T) {
+		base := NewSyncSetFromSlice([]int{1, 2, 3})
+		got := sortedSlice(SetDiff[int](base))
+		want := []int{1, 2, 3}
+		if !equalSlices(got, want) {
+			t.Fatalf("SetDiff(base) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("one other", func(t *testing.T) {
+		base := NewSyncSetFromSlice([]int{1, 2, 3})
+		other := NewSyncSetFromSlice([]int{2})
+		got := sortedSlice(SetDiff[int](base, other))
+		want := []int{1, 3}
+		if !equalSlices(got, want) {
+			t.Fatalf("SetDiff(base, other) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("three others", func(t *testing.T) {
+		base := NewSyncSetFromSlice([]int{1, 2, 3, 4, 5})
+		a := NewSyncSetFromSlice([]int{1})
+		b := NewSyncSetFromSlice([]int{2})
+		c := NewSyncSetFromSlice([]int{3})
+		got := sortedSlice(SetDiff[int](base, a, b, c))
+		want := []int{4, 5}
+		if !equalSlices(got, want) {
+			t.Fatalf("SetDiff(base, a, b, c) = %v, want %v", got, want)
+		}
+	})
+}
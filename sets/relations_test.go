@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sets
+
+import "testing"
+
+func TestSyncSet_IsEmpty(t *testing.T) {
+	if !NewSyncSet[int]().IsEmpty() {
+		t.Error("IsEmpty() on empty set = false, want true")
+	}
+	if NewSyncSetFromSlice([]int{1}).IsEmpty() {
+		t.Error("IsEmpty() on non-empty set = true, want false")
+	}
+}
+
+func TestSyncSet_Equal(t *testing.T) {
+	a := NewSyncSetFromSlice([]int{1, 2, 3})
+	b := NewSyncSetFromSlice([]int{3, 2, 1})
+	c := NewSyncSetFromSlice([]int{1, 2})
+
+	if !a.Equal(b) {
+		t.Error("Equal() on identical sets = false, want true")
+	}
+	if a.Equal(c) {
+		t.Error("Equal() on a superset vs a subset = true, want false")
+	}
+}
+
+func TestSyncSet_IsSubset(t *testing.T) {
+	small := NewSyncSetFromSlice([]int{1, 2})
+	big := NewSyncSetFromSlice([]int{1, 2, 3})
+
+	if !small.IsSubset(big) {
+		t.Error("IsSubset() = false, want true")
+	}
+	if big.IsSubset(small) {
+		t.Error("IsSubset() on a larger set = true, want false (size short-circuit)")
+	}
+	if !big.IsSubset(big) {
+		t.Error("IsSubset() of a set against itself = false, want true")
+	}
+}
+
+func TestSyncSet_IsSuperset(t *testing.T) {
+	small := NewSyncSetFromSlice([]int{1, 2})
+	big := NewSyncSetFromSlice([]int{1, 2, 3})
+
+	if !big.IsSuperset(small) {
+		t.Error("IsSuperset() = false, want true")
+	}
+	if small.IsSuperset(big) {
+		t.Error("IsSuperset() on a smaller set = true, want false (size short-circuit)")
+	}
+}
+
+func TestSyncSet_IsProperSubset(t *testing.T) {
+	small := NewSyncSetFromSlice([]int{1, 2})
+	big := NewSyncSetFromSlice([]int{1, 2, 3})
+	equalToBig := NewSyncSetFromSlice([]int{1, 2, 3})
+
+	if !small.IsProperSubset(big) {
+		t.Error("IsProperSubset() = false, want true")
+	}
+	if big.IsProperSubset(equalToBig) {
+		t.Error("IsProperSubset() on equal-size sets = true, want false")
+	}
+}
+
+func TestSyncSet_IsProperSuperset(t *testing.T) {
+	small := NewSyncSetFromSlice([]int{1, 2})
+	big := NewSyncSetFromSlice([]int{1, 2, 3})
+	equalToSmall := NewSyncSetFromSlice([]int{1, 2})
+
+	if !big.IsProperSuperset(small) {
+		t.Error("IsProperSuperset() = false, want true")
+	}
+	if small.IsProperSuperset(equalToSmall) {
+		t.Error("IsProperSuperset() on equal-size sets = true, want false")
+	}
+}
+
+func TestSyncSet_IsDisjoint(t *testing.T) {
+	a := NewSyncSetFromSlice([]int{1, 2})
+	b := NewSyncSetFromSlice([]int{3, 4})
+	c := NewSyncSetFromSlice([]int{2, 3})
+
+	if !a.IsDisjoint(b) {
+		t.Error("IsDisjoint() on non-overlapping sets = false, want true")
+	}
+	if a.IsDisjoint(c) {
+		t.Error("IsDisjoint() on overlapping sets = true, want false")
+	}
+}
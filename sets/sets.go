@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+// Package sets contains the Set interface, implemented by types such as
+// maps.Set, so that code can operate on any set implementation without
+// depending on its backing storage.
+package sets
+
+// Set holds a collection of values with no duplicates. Its methods are based
+// on the mathematical branch of set theory.
+type Set[T comparable] interface {
+	// String converts this set to its string representation.
+	String() string
+
+	// Len returns the number of elements in this set.
+	Len() int
+
+	// Has returns true if the value exists in the set.
+	Has(value T) bool
+
+	// Add will add the given values to the set, and return the number of
+	// values that were added, i.e. that did not already exist in the set.
+	Add(values ...T) int
+
+	// AddSet will add all element found in specified set to this set, and
+	// return the number of values that was added.
+	AddSet(set Set[T]) int
+
+	// Remove will remove the given values from the set, and return the
+	// number of values that were removed, i.e. that existed in the set.
+	Remove(values ...T) int
+
+	// RemoveSet will remove all element found in specified set from this
+	// set, and return the number of values that was removed.
+	RemoveSet(set Set[T]) int
+
+	// Pop removes and returns an arbitrary element from the set. The second
+	// return value is false if the set was empty, in which case the first
+	// return value is the zero value of T.
+	Pop() (T, bool)
+
+	// Clear removes all elements from the set in-place.
+	Clear()
+
+	// Clone returns a copy of the set.
+	Clone() Set[T]
+
+	// Slice returns a new slice of all values in the set.
+	Slice() []T
+
+	// Intersect performs an "intersection" on the sets and returns a new set.
+	// An intersection is a set of all elements that appear in both sets. In
+	// mathematics it's denoted as:
+	// 	A ∩ B
+	// Example:
+	// 	{1 2 3} ∩ {3 4 5} = {3}
+	// This operation is commutative, meaning you will get the same result no
+	// matter the order of the operands. In other words:
+	// 	A.Intersect(B) == B.Intersect(A)
+	Intersect(other Set[T]) Set[T]
+
+	// Union performs a "union" on the sets and returns a new set.
+	// A union is a set of all elements that appear in either set. In
+	// mathematics it's denoted as:
+	// 	A ∪ B
+	// Example:
+	// 	{1 2 3} ∪ {3 4 5} = {1 2 3 4 5}
+	// This operation is commutative, meaning you will get the same result no
+	// matter the order of the operands. In other words:
+	// 	A.Union(B) == B.Union(A)
+	Union(other Set[T]) Set[T]
+
+	// SetDiff performs a "set difference" on the sets and returns a new set.
+	// A set difference resembles a subtraction, where the result is a set of
+	// all elements that appears in the first set but not in the second. In
+	// mathematics it's denoted as:
+	// 	A \ B
+	// Example:
+	// 	{1 2 3} \ {3 4 5} = {1 2}
+	// This operation is noncommutative, meaning you will get different
+	// results depending on the order of the operands. In other words:
+	// 	A.SetDiff(B) != B.SetDiff(A)
+	SetDiff(other Set[T]) Set[T]
+
+	// SymDiff performs a "symmetric difference" on the sets and returns a new
+	// set. A symmetric difference is the set of all elements that appear in
+	// either of the sets, but not both. In mathematics it's commonly denoted
+	// as either:
+	// 	A △ B
+	// or
+	// 	A ⊖ B
+	// Example:
+	// 	{1 2 3} ⊖ {3 4 5} = {1 2 4 5}
+	// This operation is commutative, meaning you will get the same result no
+	// matter the order of the operands. In other words:
+	// 	A.SymDiff(B) == B.SymDiff(A)
+	SymDiff(other Set[T]) Set[T]
+
+	// Range calls f sequentially for each value present in the set.
+	// If f returns false, range stops the iteration.
+	//
+	// Order is not guaranteed to be the same between executions.
+	Range(f func(value T) bool)
+
+	// IsEmpty returns true if the set contains no elements.
+	IsEmpty() bool
+
+	// Equal returns true if this set and other contain exactly the same
+	// elements. In mathematics it's denoted as:
+	// 	A = B
+	Equal(other Set[T]) bool
+
+	// IsSubset returns true if other contains every element of this set. In
+	// mathematics it's denoted as:
+	// 	A ⊆ B
+	IsSubset(other Set[T]) bool
+
+	// IsSuperset returns true if this set contains every element of other.
+	// In mathematics it's denoted as:
+	// 	A ⊇ B
+	IsSuperset(other Set[T]) bool
+
+	// IsProperSubset returns true if other contains every element of this
+	// set, and other has more elements than this set. In mathematics it's
+	// denoted as:
+	// 	A ⊂ B
+	IsProperSubset(other Set[T]) bool
+
+	// IsProperSuperset returns true if this set contains every element of
+	// other, and this set has more elements than other. In mathematics it's
+	// denoted as:
+	// 	A ⊃ B
+	IsProperSuperset(other Set[T]) bool
+
+	// IsDisjoint returns true if this set and other share no elements. In
+	// mathematics it's denoted as:
+	// 	A ∩ B = ∅
+	IsDisjoint(other Set[T]) bool
+}
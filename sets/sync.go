@@ -0,0 +1,412 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sets
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// SyncSet holds a collection of values with no duplicates. It is the
+// concurrency-safe counterpart to maps.Set, and is safe for use by multiple
+// goroutines simultaneously.
+//
+// The zero value is not usable; use NewSyncSet or NewSyncSetFromSlice to
+// construct one.
+type SyncSet[T comparable] struct {
+	mu sync.RWMutex
+	m  map[T]struct{}
+}
+
+// NewSyncSet returns a new, empty SyncSet.
+func NewSyncSet[T comparable]() *SyncSet[T] {
+	return &SyncSet[T]{m: make(map[T]struct{})}
+}
+
+// NewSyncSetFromSlice returns a SyncSet with all values from a slice added
+// to it.
+func NewSyncSetFromSlice[S ~[]E, E comparable](slice S) *SyncSet[E] {
+	set := NewSyncSet[E]()
+	for _, v := range slice {
+		set.Add(v)
+	}
+	return set
+}
+
+// String converts this set to its string representation.
+func (s *SyncSet[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var sb strings.Builder
+	sb.WriteByte('{')
+	addDelim := false
+	for v := range s.m {
+		if addDelim {
+			sb.WriteByte(' ')
+		} else {
+			addDelim = true
+		}
+		fmt.Fprint(&sb, v)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// Len returns the number of elements in this set.
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.m)
+}
+
+// Has returns true if the value exists in the set.
+func (s *SyncSet[T]) Has(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, has := s.m[value]
+	return has
+}
+
+// Add will add the given values to the set, and return the number of
+// values that were added, i.e. that did not already exist in the set.
+func (s *SyncSet[T]) Add(values ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var added int
+	for _, value := range values {
+		if _, has := s.m[value]; has {
+			continue
+		}
+		s.m[value] = struct{}{}
+		added++
+	}
+	return added
+}
+
+// AddSet will add all element found in specified set to this set, and
+// return the number of values that was added.
+func (s *SyncSet[T]) AddSet(set Set[T]) int {
+	var added int
+	set.Range(func(value T) bool {
+		added += s.Add(value)
+		return true
+	})
+	return added
+}
+
+// Remove will remove the given values from the set, and return the number
+// of values that were removed, i.e. that existed in the set.
+func (s *SyncSet[T]) Remove(values ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var removed int
+	for _, value := range values {
+		if _, has := s.m[value]; !has {
+			continue
+		}
+		delete(s.m, value)
+		removed++
+	}
+	return removed
+}
+
+// RemoveSet will remove all element found in specified set from this set,
+// and return the number of values that was removed.
+func (s *SyncSet[T]) RemoveSet(set Set[T]) int {
+	var removed int
+	set.Range(func(value T) bool {
+		removed += s.Remove(value)
+		return true
+	})
+	return removed
+}
+
+// Pop removes and returns an arbitrary element from the set. The second
+// return value is false if the set was empty, in which case the first
+// return value is the zero value of T.
+func (s *SyncSet[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for v := range s.m {
+		delete(s.m, v)
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Clear removes all elements from the set in-place.
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for v := range s.m {
+		delete(s.m, v)
+	}
+}
+
+// Clone returns a copy of the set.
+func (s *SyncSet[T]) Clone() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	clone := NewSyncSet[T]()
+	for v := range s.m {
+		clone.m[v] = struct{}{}
+	}
+	return clone
+}
+
+// Slice returns a new slice of all values in the set, taken as a snapshot
+// under a read lock so the result can't observe a torn state.
+func (s *SyncSet[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]T, 0, len(s.m))
+	for v := range s.m {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Intersect performs an "intersection" on the sets and returns a new set.
+// An intersection is a set of all elements that appear in both sets. In
+// mathematics it's denoted as:
+// 	A ∩ B
+// Example:
+// 	{1 2 3} ∩ {3 4 5} = {3}
+// This operation is commutative, meaning you will get the same result no
+// matter the order of the operands. In other words:
+// 	A.Intersect(B) == B.Intersect(A)
+//
+// If other is also a *SyncSet, both sets are locked for the duration of the
+// operation, ordered by memory address to avoid deadlocking against a
+// concurrent call with the operands reversed.
+func (s *SyncSet[T]) Intersect(other Set[T]) Set[T] {
+	result := NewSyncSet[T]()
+	if o, ok := other.(*SyncSet[T]); ok {
+		unlock := rlockPair(s, o)
+		defer unlock()
+		for v := range s.m {
+			if _, has := o.m[v]; has {
+				result.m[v] = struct{}{}
+			}
+		}
+		return result
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for v := range s.m {
+		if other.Has(v) {
+			result.m[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Union performs a "union" on the sets and returns a new set.
+// A union is a set of all elements that appear in either set. In
+// mathematics it's denoted as:
+// 	A ∪ B
+// Example:
+// 	{1 2 3} ∪ {3 4 5} = {1 2 3 4 5}
+// This operation is commutative, meaning you will get the same result no
+// matter the order of the operands. In other words:
+// 	A.Union(B) == B.Union(A)
+func (s *SyncSet[T]) Union(other Set[T]) Set[T] {
+	result := s.Clone().(*SyncSet[T])
+	result.AddSet(other)
+	return result
+}
+
+// SetDiff performs a "set difference" on the sets and returns a new set.
+// A set difference resembles a subtraction, where the result is a set of all
+// elements that appears in the first set but not in the second. In
+// mathematics it's denoted as:
+// 	A \ B
+// Example:
+// 	{1 2 3} \ {3 4 5} = {1 2}
+// This operation is noncommutative, meaning you will get different results
+// depending on the order of the operands. In other words:
+// 	A.SetDiff(B) != B.SetDiff(A)
+//
+// If other is also a *SyncSet, both sets are locked for the duration of the
+// operation, ordered by memory address to avoid deadlocking against a
+// concurrent call with the operands reversed.
+func (s *SyncSet[T]) SetDiff(other Set[T]) Set[T] {
+	result := NewSyncSet[T]()
+	if o, ok := other.(*SyncSet[T]); ok {
+		unlock := rlockPair(s, o)
+		defer unlock()
+		for v := range s.m {
+			if _, has := o.m[v]; !has {
+				result.m[v] = struct{}{}
+			}
+		}
+		return result
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for v := range s.m {
+		if !other.Has(v) {
+			result.m[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymDiff performs a "symmetric difference" on the sets and returns a new
+// set. A symmetric difference is the set of all elements that appear in
+// either of the sets, but not both. In mathematics it's commonly denoted as
+// either:
+// 	A △ B
+// or
+// 	A ⊖ B
+// Example:
+// 	{1 2 3} ⊖ {3 4 5} = {1 2 4 5}
+// This operation is commutative, meaning you will get the same result no
+// matter the order of the operands. In other words:
+// 	A.SymDiff(B) == B.SymDiff(A)
+func (s *SyncSet[T]) SymDiff(other Set[T]) Set[T] {
+	result := s.SetDiff(other).(*SyncSet[T])
+	other.Range(func(value T) bool {
+		if !s.Has(value) {
+			result.Add(value)
+		}
+		return true
+	})
+	return result
+}
+
+// Range calls f sequentially for each value present in the set, iterating
+// over a snapshot taken under a read lock so callers can't observe a torn
+// state and so f is free to call back into the set without deadlocking.
+// If f returns false, range stops the iteration.
+//
+// Order is not guaranteed to be the same between executions.
+func (s *SyncSet[T]) Range(f func(value T) bool) {
+	for _, v := range s.Slice() {
+		if !f(v) {
+			break
+		}
+	}
+}
+
+// IsEmpty returns true if the set contains no elements.
+func (s *SyncSet[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Equal returns true if this set and other contain exactly the same
+// elements. In mathematics it's denoted as:
+// 	A = B
+func (s *SyncSet[T]) Equal(other Set[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	return s.IsSubset(other)
+}
+
+// IsSubset returns true if other contains every element of this set. In
+// mathematics it's denoted as:
+// 	A ⊆ B
+//
+// If other is also a *SyncSet, both sets are locked for the duration of the
+// operation, ordered by memory address to avoid deadlocking against a
+// concurrent call with the operands reversed.
+func (s *SyncSet[T]) IsSubset(other Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	if o, ok := other.(*SyncSet[T]); ok {
+		unlock := rlockPair(s, o)
+		defer unlock()
+		for v := range s.m {
+			if _, has := o.m[v]; !has {
+				return false
+			}
+		}
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for v := range s.m {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if this set contains every element of other. In
+// mathematics it's denoted as:
+// 	A ⊇ B
+func (s *SyncSet[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsProperSubset returns true if other contains every element of this set,
+// and other has more elements than this set. In mathematics it's denoted as:
+// 	A ⊂ B
+func (s *SyncSet[T]) IsProperSubset(other Set[T]) bool {
+	return s.Len() < other.Len() && s.IsSubset(other)
+}
+
+// IsProperSuperset returns true if this set contains every element of
+// other, and this set has more elements than other. In mathematics it's
+// denoted as:
+// 	A ⊃ B
+func (s *SyncSet[T]) IsProperSuperset(other Set[T]) bool {
+	return other.IsProperSubset(s)
+}
+
+// IsDisjoint returns true if this set and other share no elements. In
+// mathematics it's denoted as:
+// 	A ∩ B = ∅
+//
+// If other is also a *SyncSet, both sets are locked for the duration of the
+// operation, ordered by memory address to avoid deadlocking against a
+// concurrent call with the operands reversed.
+func (s *SyncSet[T]) IsDisjoint(other Set[T]) bool {
+	if o, ok := other.(*SyncSet[T]); ok {
+		unlock := rlockPair(s, o)
+		defer unlock()
+		for v := range s.m {
+			if _, has := o.m[v]; has {
+				return false
+			}
+		}
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for v := range s.m {
+		if other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// rlockPair read-locks both sets for the duration of an operation, ordering
+// the locks by the sets' memory addresses so that two concurrent calls with
+// reversed operands (e.g. a.Intersect(b) and b.Intersect(a)) can never
+// deadlock. It returns a function that unlocks both sets in reverse order.
+func rlockPair[T comparable](a, b *SyncSet[T]) (unlock func()) {
+	if a == b {
+		a.mu.RLock()
+		return a.mu.RUnlock
+	}
+	first, second := a, b
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		first, second = b, a
+	}
+	first.mu.RLock()
+	second.mu.RLock()
+	return func() {
+		second.mu.RUnlock()
+		first.mu.RUnlock()
+	}
+}
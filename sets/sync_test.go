@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2022 Kalle Fagerberg
+//
+// SPDX-License-Identifier: MIT
+
+package sets
+
+import (
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSyncSet_basics(t *testing.T) {
+	s := NewSyncSetFromSlice([]int{1, 2, 3})
+	if s.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", s.Len())
+	}
+	if !s.Has(2) {
+		t.Fatal("Has(2) = false, want true")
+	}
+	if s.Has(4) {
+		t.Fatal("Has(4) = true, want false")
+	}
+	if added := s.Add(4, 2); added != 1 {
+		t.Fatalf("Add(4, 2) = %d, want 1", added)
+	}
+	if removed := s.Remove(4, 5); removed != 1 {
+		t.Fatalf("Remove(4, 5) = %d, want 1", removed)
+	}
+}
+
+func TestSyncSet_Pop(t *testing.T) {
+	s := NewSyncSetFromSlice([]int{1, 2, 3})
+
+	seen := NewSyncSet[int]()
+	for i := 0; i < 3; i++ {
+		v, ok := s.Pop()
+		if !ok {
+			t.Fatalf("Pop() ok = false on iteration %d, want true", i)
+		}
+		if seen.Has(v) {
+			t.Fatalf("Pop() returned %d twice", v)
+		}
+		seen.Add(v)
+	}
+	if !s.IsEmpty() {
+		t.Fatalf("set should be empty after popping all elements, got %v", s)
+	}
+
+	_, ok := s.Pop()
+	if ok {
+		t.Error("Pop() on empty set ok = true, want false")
+	}
+}
+
+func TestSyncSet_Clear(t *testing.T) {
+	s := NewSyncSetFromSlice([]int{1, 2, 3})
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatalf("Clear() left set = %v, want empty", s)
+	}
+	if s.Add(4) != 1 {
+		t.Error("set should still be usable after Clear()")
+	}
+}
+
+// TestSyncSet_concurrentMixedAccess exercises concurrent reads, writes, and
+// set-vs-set operations (in both operand orders) across many goroutines.
+// Run with -race to catch data races in the locking scheme.
+func TestSyncSet_concurrentMixedAccess(t *testing.T) {
+	const goroutines = 6
+	const iterations = 200
+
+	a := NewSyncSet[int]()
+	b := NewSyncSet[int]()
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				v := g*iterations + i
+				a.Add(v)
+				b.Add(v + 1)
+
+				a.Has(v)
+				b.Has(v)
+
+				a.Intersect(b)
+				b.Intersect(a)
+
+				a.Union(b)
+				b.Union(a)
+
+				a.SetDiff(b)
+				b.SetDiff(a)
+
+				a.SymDiff(b)
+				b.SymDiff(a)
+
+				a.IsSubset(b)
+				b.IsSubset(a)
+
+				a.IsDisjoint(b)
+				b.IsDisjoint(a)
+
+				a.Slice()
+				b.Range(func(int) bool { return true })
+
+				a.Remove(v)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+// TestSyncSet_selfOperand covers the a == b case in rlockPair, where an
+// operation is performed against the receiver itself.
+func TestSyncSet_selfOperand(t *testing.T) {
+	s := NewSyncSetFromSlice([]int{1, 2, 3})
+	if !s.Equal(s) {
+		t.Fatal("Equal(self) = false, want true")
+	}
+	if !s.IsSubset(s) {
+		t.Fatal("IsSubset(self) = false, want true")
+	}
+	if got := s.Intersect(s).Slice(); len(got) != 3 {
+		t.Fatalf("Intersect(self) = %v, want 3 elements", got)
+	}
+}
+
+// TestSyncSet_reversedOperands checks that a.Op(b) and b.Op(a) both
+// terminate and agree with each other, the scenario the pointer-address
+// lock ordering in rlockPair exists to protect.
+func TestSyncSet_reversedOperands(t *testing.T) {
+	a := NewSyncSetFromSlice([]int{1, 2, 3})
+	b := NewSyncSetFromSlice([]int{2, 3, 4})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	var ab, ba []int
+	go func() {
+		defer wg.Done()
+		ab = a.Intersect(b).Slice()
+	}()
+	go func() {
+		defer wg.Done()
+		ba = b.Intersect(a).Slice()
+	}()
+	wg.Wait()
+
+	sort.Ints(ab)
+	sort.Ints(ba)
+	want := []int{2, 3}
+	if !equalInts(ab, want) {
+		t.Errorf("a.Intersect(b) = %v, want %v", ab, want)
+	}
+	if !equalInts(ba, want) {
+		t.Errorf("b.Intersect(a) = %v, want %v", ba, want)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}